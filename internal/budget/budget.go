@@ -0,0 +1,168 @@
+// Package budget evaluates spend caps configured per model, provider, or
+// project against aggregated usage costs, projecting end-of-period spend
+// via simple linear extrapolation over a lookback window.
+package budget
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/razobeckett/openmetrics/internal/db"
+)
+
+// Period is a budget cap's reset cadence.
+type Period string
+
+const (
+	PeriodDaily   Period = "daily"
+	PeriodWeekly  Period = "weekly"
+	PeriodMonthly Period = "monthly"
+)
+
+// Duration returns the wall-clock length of a period, used to project
+// current spend forward to a full period.
+func (p Period) Duration() time.Duration {
+	switch p {
+	case PeriodWeekly:
+		return 7 * 24 * time.Hour
+	case PeriodMonthly:
+		return 30 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// Cap is a single spend limit scoped to a model, a provider, or (when
+// neither is set) the whole project.
+type Cap struct {
+	Name     string  `json:"name" yaml:"name"`
+	Model    string  `json:"model,omitempty" yaml:"model,omitempty"`
+	Provider string  `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Period   Period  `json:"period" yaml:"period"`
+	LimitUSD float64 `json:"limit_usd" yaml:"limit_usd"`
+}
+
+// Config is the on-disk budget configuration: a flat list of caps.
+type Config struct {
+	Caps []Cap `json:"caps" yaml:"caps"`
+}
+
+// Load reads a budget config from path, parsing it as YAML or JSON based on
+// its file extension (.yaml/.yml vs anything else).
+func Load(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("budget: failed to read config: %w", err)
+	}
+
+	var cfg Config
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("budget: failed to parse YAML config: %w", err)
+		}
+	default:
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("budget: failed to parse JSON config: %w", err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// Status is the green/yellow/red health of a Cap's projected spend.
+type Status string
+
+const (
+	StatusGreen  Status = "green"
+	StatusYellow Status = "yellow"
+	StatusRed    Status = "red"
+)
+
+// Evaluation matches a Cap against actual spend and a linear projection of
+// spend through the end of its period.
+type Evaluation struct {
+	Cap Cap
+
+	// CurrentSpend is LIFETIME spend for the cap's model/provider filter,
+	// not spend within the cap's period: db.ModelStats carries no
+	// per-message timestamps, so there's nothing to scope it to the
+	// period with. Treat it as "total spend observed so far" — display
+	// only. Status is gated on ProjectedSpend alone (see Evaluate), since
+	// gating on lifetime spend too would lock any long-lived project's
+	// caps to red forever regardless of its actual current burn rate.
+	CurrentSpend   float64
+	ProjectedSpend float64
+	Status         Status
+}
+
+// Evaluate matches each cap in cfg against models (filtered by the cap's
+// model/provider, or all models for a project-wide cap) and linearly
+// extrapolates CurrentSpend, observed over lookback, to the cap's full
+// period to get ProjectedSpend. Status is gated on ProjectedSpend alone:
+// CurrentSpend is lifetime spend with no period boundary, so a project
+// that's been running longer than cap.Period would otherwise see status
+// stuck at red forever once lifetime spend first crossed the limit, no
+// matter how low its actual current burn rate is. This does mean a cap can
+// read green even while CurrentSpend already exceeds LimitUSD, if the
+// lookback window is long enough to project a low burn rate — that's the
+// accepted looser guarantee until per-message timestamps let CurrentSpend
+// itself be scoped to the period.
+func Evaluate(cfg Config, models []db.ModelStats, lookback time.Duration) []Evaluation {
+	evaluations := make([]Evaluation, 0, len(cfg.Caps))
+
+	for _, cap := range cfg.Caps {
+		var spend float64
+		for _, m := range models {
+			if cap.Model != "" && m.Model != cap.Model {
+				continue
+			}
+			if cap.Provider != "" && m.Provider != cap.Provider {
+				continue
+			}
+			spend += m.Cost
+		}
+
+		projected := spend
+		if lookback > 0 {
+			projected = spend * (float64(cap.Period.Duration()) / float64(lookback))
+		}
+
+		status := StatusGreen
+		if cap.LimitUSD > 0 {
+			ratio := projected / cap.LimitUSD
+			switch {
+			case ratio >= 1:
+				status = StatusRed
+			case ratio >= 0.8:
+				status = StatusYellow
+			}
+		}
+
+		evaluations = append(evaluations, Evaluation{
+			Cap:            cap,
+			CurrentSpend:   spend,
+			ProjectedSpend: projected,
+			Status:         status,
+		})
+	}
+
+	return evaluations
+}
+
+// Exceeded reports whether any evaluation's projected spend is at or over
+// its cap, for use as a CI gate.
+func Exceeded(evaluations []Evaluation) bool {
+	for _, e := range evaluations {
+		if e.Status == StatusRed {
+			return true
+		}
+	}
+	return false
+}
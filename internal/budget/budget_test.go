@@ -0,0 +1,98 @@
+package budget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/razobeckett/openmetrics/internal/db"
+)
+
+func TestEvaluateStatus(t *testing.T) {
+	cases := []struct {
+		name       string
+		cfg        Config
+		models     []db.ModelStats
+		lookback   time.Duration
+		wantStatus Status
+	}{
+		{
+			name: "long-lived project with a low current burn rate reads green",
+			cfg: Config{Caps: []Cap{
+				{Name: "daily", Period: PeriodDaily, LimitUSD: 5},
+			}},
+			models:     []db.ModelStats{{Model: "gpt-4", Cost: 30}},
+			lookback:   30 * 24 * time.Hour,
+			wantStatus: StatusGreen,
+		},
+		{
+			name: "projected spend over the limit reads red",
+			cfg: Config{Caps: []Cap{
+				{Name: "daily", Period: PeriodDaily, LimitUSD: 5},
+			}},
+			models:     []db.ModelStats{{Model: "gpt-4", Cost: 10}},
+			lookback:   24 * time.Hour,
+			wantStatus: StatusRed,
+		},
+		{
+			name: "projected spend at 80% of the limit reads yellow",
+			cfg: Config{Caps: []Cap{
+				{Name: "daily", Period: PeriodDaily, LimitUSD: 5},
+			}},
+			models:     []db.ModelStats{{Model: "gpt-4", Cost: 4}},
+			lookback:   24 * time.Hour,
+			wantStatus: StatusYellow,
+		},
+		{
+			name: "zero limit disables gating",
+			cfg: Config{Caps: []Cap{
+				{Name: "uncapped", Period: PeriodDaily, LimitUSD: 0},
+			}},
+			models:     []db.ModelStats{{Model: "gpt-4", Cost: 1000}},
+			lookback:   24 * time.Hour,
+			wantStatus: StatusGreen,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			evaluations := Evaluate(tc.cfg, tc.models, tc.lookback)
+			if len(evaluations) != 1 {
+				t.Fatalf("Evaluate returned %d evaluations, want 1", len(evaluations))
+			}
+			if got := evaluations[0].Status; got != tc.wantStatus {
+				t.Errorf("Status = %q, want %q (projected=%v, current=%v)",
+					got, tc.wantStatus, evaluations[0].ProjectedSpend, evaluations[0].CurrentSpend)
+			}
+		})
+	}
+}
+
+func TestEvaluateFiltersByModelAndProvider(t *testing.T) {
+	cfg := Config{Caps: []Cap{
+		{Name: "gpt-4 only", Model: "gpt-4", Period: PeriodDaily, LimitUSD: 10},
+	}}
+	models := []db.ModelStats{
+		{Model: "gpt-4", Provider: "openai", Cost: 3},
+		{Model: "claude", Provider: "anthropic", Cost: 100},
+	}
+
+	evaluations := Evaluate(cfg, models, 24*time.Hour)
+	if len(evaluations) != 1 {
+		t.Fatalf("Evaluate returned %d evaluations, want 1", len(evaluations))
+	}
+	if got := evaluations[0].CurrentSpend; got != 3 {
+		t.Errorf("CurrentSpend = %v, want 3 (claude's cost should be excluded)", got)
+	}
+}
+
+func TestExceeded(t *testing.T) {
+	red := []Evaluation{{Status: StatusGreen}, {Status: StatusRed}}
+	if !Exceeded(red) {
+		t.Error("Exceeded(evaluations containing a red status) = false, want true")
+	}
+
+	clean := []Evaluation{{Status: StatusGreen}, {Status: StatusYellow}}
+	if Exceeded(clean) {
+		t.Error("Exceeded(evaluations with no red status) = true, want false")
+	}
+}
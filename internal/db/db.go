@@ -28,11 +28,14 @@ type MessageData struct {
 
 // ModelStats aggregates statistics for a specific model
 type ModelStats struct {
-	Model        string
-	Provider     string
-	InputTokens  int64
-	OutputTokens int64
-	Cost         float64
+	Model             string
+	Provider          string
+	InputTokens       int64
+	OutputTokens      int64
+	CachedReadTokens  int64
+	CachedWriteTokens int64
+	ReasoningTokens   int64
+	Cost              float64
 }
 
 // Session represents a session with aggregated data
@@ -106,8 +109,11 @@ func (db *DB) GetModelStats() ([]ModelStats, error) {
 			}
 		}
 
-		statsMap[key].InputTokens += data.Tokens.Input + data.Tokens.Cache.Read
+		statsMap[key].InputTokens += data.Tokens.Input
 		statsMap[key].OutputTokens += data.Tokens.Output
+		statsMap[key].CachedReadTokens += data.Tokens.Cache.Read
+		statsMap[key].CachedWriteTokens += data.Tokens.Cache.Write
+		statsMap[key].ReasoningTokens += data.Tokens.Reasoning
 		statsMap[key].Cost += data.Cost
 	}
 
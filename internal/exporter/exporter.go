@@ -0,0 +1,144 @@
+// Package exporter serves aggregated opencode usage stats in the
+// OpenMetrics/Prometheus text exposition format so they can be scraped by
+// Prometheus and graphed in Grafana.
+package exporter
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/razobeckett/openmetrics/internal/db"
+	"github.com/razobeckett/openmetrics/internal/ingest"
+	"github.com/razobeckett/openmetrics/internal/pricing"
+)
+
+// Exporter renders db.ModelStats and db.Session data, priced via a
+// pricing.PricingService, as OpenMetrics text. It re-queries its sources at
+// most once per minInterval to avoid hammering them on frequent scrapes.
+type Exporter struct {
+	sources        []string
+	pricingService *pricing.PricingService
+	minInterval    time.Duration
+
+	mu       sync.Mutex
+	cachedAt time.Time
+	cached   []byte
+}
+
+// New creates an Exporter that reads and merges sources (--source specs
+// parsed by ingest.Parse) and prices usage via ps. ps may be nil, in which
+// case cost metrics report only the cost each source recorded (no fallback
+// pricing) and openmetrics_models_priced reports 0.
+func New(sources []string, ps *pricing.PricingService, minInterval time.Duration) *Exporter {
+	return &Exporter{
+		sources:        sources,
+		pricingService: ps,
+		minInterval:    minInterval,
+	}
+}
+
+// Handler returns an http.Handler that serves the current snapshot at
+// whatever path it's mounted on (conventionally /metrics).
+func (e *Exporter) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := e.snapshot()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/openmetrics-text; version=1.0.0; charset=utf-8")
+		w.Write(body)
+	})
+}
+
+// snapshot returns the cached render if it's still fresh, otherwise
+// re-queries the database and refreshes the cache.
+func (e *Exporter) snapshot() ([]byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cached != nil && time.Since(e.cachedAt) < e.minInterval {
+		return e.cached, nil
+	}
+
+	body, err := e.render()
+	if err != nil {
+		return nil, err
+	}
+
+	e.cached = body
+	e.cachedAt = time.Now()
+	return body, nil
+}
+
+func (e *Exporter) render() ([]byte, error) {
+	all := make([]ingest.Stats, 0, len(e.sources))
+	for _, spec := range e.sources {
+		ingestor, err := ingest.Parse(spec)
+		if err != nil {
+			return nil, fmt.Errorf("exporter: %w", err)
+		}
+
+		stats, err := ingestor.Load()
+		if err != nil {
+			return nil, fmt.Errorf("exporter: failed to load %s: %w", ingestor.Name(), err)
+		}
+		all = append(all, stats)
+	}
+
+	merged := ingest.Merge(all)
+	models := merged.Models
+	sessions := merged.Sessions
+
+	var b strings.Builder
+
+	writeHeader(&b, "openmetrics_model_input_tokens_total", "counter", "Total input tokens consumed, per model and provider")
+	for _, m := range models {
+		fmt.Fprintf(&b, "openmetrics_model_input_tokens_total{model=%q,provider=%q} %d\n", m.Model, m.Provider, m.InputTokens)
+	}
+
+	writeHeader(&b, "openmetrics_model_output_tokens_total", "counter", "Total output tokens produced, per model and provider")
+	for _, m := range models {
+		fmt.Fprintf(&b, "openmetrics_model_output_tokens_total{model=%q,provider=%q} %d\n", m.Model, m.Provider, m.OutputTokens)
+	}
+
+	writeHeader(&b, "openmetrics_model_cost_usd_total", "counter", "Total cost in USD, per model and provider")
+	for _, m := range models {
+		fmt.Fprintf(&b, "openmetrics_model_cost_usd_total{model=%q,provider=%q} %g\n", m.Model, m.Provider, e.costFor(m))
+	}
+
+	writeHeader(&b, "openmetrics_session_messages", "gauge", "Message count per session")
+	for _, s := range sessions {
+		fmt.Fprintf(&b, "openmetrics_session_messages{session_id=%q,title=%q} %d\n", s.ID, s.Title, s.MessageCount)
+	}
+
+	writeHeader(&b, "openmetrics_session_tokens_total", "counter", "Total tokens per session")
+	for _, s := range sessions {
+		fmt.Fprintf(&b, "openmetrics_session_tokens_total{session_id=%q,title=%q} %d\n", s.ID, s.Title, s.TotalTokens)
+	}
+
+	writeHeader(&b, "openmetrics_models_priced", "gauge", "Number of models with known pricing data loaded")
+	modelsPriced := 0
+	if e.pricingService != nil {
+		modelsPriced = e.pricingService.GetModelCount()
+	}
+	fmt.Fprintf(&b, "openmetrics_models_priced %d\n", modelsPriced)
+
+	b.WriteString("# EOF\n")
+
+	return []byte(b.String()), nil
+}
+
+// costFor returns m.Cost when the database already recorded one, falling
+// back to a price computed from raw token counts.
+func (e *Exporter) costFor(m db.ModelStats) float64 {
+	return pricing.EffectiveCost(m, e.pricingService)
+}
+
+func writeHeader(b *strings.Builder, name, typ, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s %s\n", name, typ)
+}
@@ -0,0 +1,107 @@
+package ingest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/razobeckett/openmetrics/internal/db"
+)
+
+// JSONLRecord is one line of a generic usage log: one record per API call
+// or message, independent of any particular gateway's schema.
+type JSONLRecord struct {
+	Model        string  `json:"model"`
+	Provider     string  `json:"provider"`
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	CachedTokens int64   `json:"cached_tokens"`
+	Cost         float64 `json:"cost"`
+	SessionID    string  `json:"session_id"`
+	Timestamp    int64   `json:"ts"`
+}
+
+// JSONLIngestor reads a JSONL usage log and aggregates it into the same
+// shape as the opencode ingestor.
+type JSONLIngestor struct {
+	path string
+}
+
+// NewJSONLIngestor creates a JSONLIngestor reading path.
+func NewJSONLIngestor(path string) *JSONLIngestor {
+	return &JSONLIngestor{path: path}
+}
+
+// Name implements Ingestor.
+func (j *JSONLIngestor) Name() string {
+	return "jsonl:" + j.path
+}
+
+// Load implements Ingestor.
+func (j *JSONLIngestor) Load() (Stats, error) {
+	f, err := os.Open(j.path)
+	if err != nil {
+		return Stats{}, fmt.Errorf("%s: %w", j.Name(), err)
+	}
+	defer f.Close()
+
+	modelsByKey := make(map[string]*db.ModelStats)
+	sessionsByID := make(map[string]*db.Session)
+	messages := 0
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var rec JSONLRecord
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			continue
+		}
+		messages++
+
+		key := rec.Model + "|" + rec.Provider
+		stats := modelsByKey[key]
+		if stats == nil {
+			stats = &db.ModelStats{Model: rec.Model, Provider: rec.Provider}
+			modelsByKey[key] = stats
+		}
+		stats.InputTokens += rec.InputTokens
+		stats.OutputTokens += rec.OutputTokens
+		stats.CachedReadTokens += rec.CachedTokens
+		stats.Cost += rec.Cost
+
+		if rec.SessionID != "" {
+			sess := sessionsByID[rec.SessionID]
+			if sess == nil {
+				sess = &db.Session{ID: rec.SessionID, Title: rec.SessionID}
+				sessionsByID[rec.SessionID] = sess
+			}
+			sess.MessageCount++
+			sess.TotalTokens += rec.InputTokens + rec.OutputTokens + rec.CachedTokens
+			if rec.Timestamp > sess.TimeUpdated {
+				sess.TimeUpdated = rec.Timestamp
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Stats{}, fmt.Errorf("%s: %w", j.Name(), err)
+	}
+
+	models := make([]db.ModelStats, 0, len(modelsByKey))
+	for _, m := range modelsByKey {
+		models = append(models, *m)
+	}
+
+	sessions := make([]db.Session, 0, len(sessionsByID))
+	for _, s := range sessionsByID {
+		sessions = append(sessions, *s)
+	}
+
+	return Stats{Models: models, Sessions: sessions, Messages: messages}, nil
+}
@@ -0,0 +1,54 @@
+package ingest
+
+import (
+	"fmt"
+
+	"github.com/razobeckett/openmetrics/internal/db"
+)
+
+// OpencodeIngestor reads usage stats from an opencode SQLite database. It's
+// a thin adapter over the db package so opencode stays the default source.
+type OpencodeIngestor struct {
+	dbPath string
+}
+
+// NewOpencodeIngestor creates an OpencodeIngestor reading dbPath.
+func NewOpencodeIngestor(dbPath string) *OpencodeIngestor {
+	return &OpencodeIngestor{dbPath: dbPath}
+}
+
+// Name implements Ingestor.
+func (o *OpencodeIngestor) Name() string {
+	return "opencode:" + o.dbPath
+}
+
+// Load implements Ingestor.
+func (o *OpencodeIngestor) Load() (Stats, error) {
+	database, err := db.New(o.dbPath)
+	if err != nil {
+		return Stats{}, fmt.Errorf("%s: %w", o.Name(), err)
+	}
+	defer database.Close()
+
+	models, err := database.GetModelStats()
+	if err != nil {
+		return Stats{}, fmt.Errorf("%s: %w", o.Name(), err)
+	}
+
+	sessions, err := database.GetSessions()
+	if err != nil {
+		return Stats{}, fmt.Errorf("%s: %w", o.Name(), err)
+	}
+
+	projects, _, messages, err := database.GetTotalCounts()
+	if err != nil {
+		return Stats{}, fmt.Errorf("%s: %w", o.Name(), err)
+	}
+
+	return Stats{
+		Models:   models,
+		Sessions: sessions,
+		Projects: projects,
+		Messages: messages,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package pricing
+
+import "github.com/razobeckett/openmetrics/internal/db"
+
+// BreakdownFor computes m's cost breakdown via ps, regardless of whether
+// m.Cost is already set, so callers needing the input/output/cache split
+// (e.g. for "In ($)"/"Out ($)" columns) don't each hand-roll the same
+// db.ModelStats-to-Usage conversion. Returns a zero CostBreakdown if ps is
+// nil.
+func BreakdownFor(m db.ModelStats, ps *PricingService) CostBreakdown {
+	if ps == nil {
+		return CostBreakdown{}
+	}
+	return ps.CalculateCost(m.Model, Usage{
+		InputTokens:       m.InputTokens,
+		OutputTokens:      m.OutputTokens,
+		CachedReadTokens:  m.CachedReadTokens,
+		CachedWriteTokens: m.CachedWriteTokens,
+		ReasoningTokens:   m.ReasoningTokens,
+	})
+}
+
+// EffectiveCost returns m.Cost when the database already recorded one,
+// falling back to BreakdownFor(m, ps).Total() when it didn't.
+func EffectiveCost(m db.ModelStats, ps *PricingService) float64 {
+	if m.Cost != 0 || ps == nil {
+		return m.Cost
+	}
+	return BreakdownFor(m, ps).Total()
+}
@@ -1,7 +1,9 @@
 package pricing
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -42,41 +44,86 @@ type PricingService struct {
 	pricing     map[string]ModelPricing
 	lastFetched time.Time
 	httpClient  *http.Client
+	sources     []PricingSource
 }
 
-// NewPricingService creates a new pricing service
-func NewPricingService() *PricingService {
-	return &PricingService{
+// Option configures a PricingService at construction time.
+type Option func(*PricingService)
+
+// WithSources sets the ordered chain of sources to try on each Refresh. The
+// first source that returns data (or ErrNotModified) wins; later sources are
+// only consulted if earlier ones fail outright.
+func WithSources(sources ...PricingSource) Option {
+	return func(p *PricingService) {
+		p.sources = sources
+	}
+}
+
+// NewPricingService creates a new pricing service. By default it fetches
+// from the LiteLLM HTTP endpoint and falls back to the embedded snapshot if
+// that fails; pass WithSources to customize the chain (e.g. prefer a local
+// file for offline/air-gapped use).
+func NewPricingService(opts ...Option) *PricingService {
+	p := &PricingService{
 		pricing: make(map[string]ModelPricing),
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 	}
+
+	p.sources = []PricingSource{NewHTTPSource(ModelCostMapURL), NewEmbeddedSource()}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
 }
 
-// FetchPricing fetches the latest pricing data from LiteLLM
+// FetchPricing fetches the latest pricing data from LiteLLM. Deprecated: use
+// Refresh, which respects a context deadline and tries the configured source
+// chain instead of talking to LiteLLM directly.
 func (p *PricingService) FetchPricing() error {
-	resp, err := p.httpClient.Get(ModelCostMapURL)
-	if err != nil {
-		return fmt.Errorf("failed to fetch pricing data: %w", err)
-	}
-	defer resp.Body.Close()
+	return p.Refresh(context.Background())
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
+// Refresh walks the configured source chain, applying the first source that
+// returns data. A source returning ErrNotModified is treated as success
+// without replacing the cached pricing map. ctx's deadline bounds the whole
+// operation, including any in-flight HTTP request.
+func (p *PricingService) Refresh(ctx context.Context) error {
+	var lastErr error
 
-	var pricing map[string]ModelPricing
-	if err := json.NewDecoder(resp.Body).Decode(&pricing); err != nil {
-		return fmt.Errorf("failed to decode pricing data: %w", err)
-	}
+	for _, src := range p.sources {
+		data, err := src.Fetch(ctx)
+		if err != nil {
+			if errors.Is(err, ErrNotModified) {
+				p.mu.Lock()
+				p.lastFetched = time.Now()
+				p.mu.Unlock()
+				return nil
+			}
+			lastErr = fmt.Errorf("%s: %w", src.Name(), err)
+			continue
+		}
 
-	p.mu.Lock()
-	p.pricing = pricing
-	p.lastFetched = time.Now()
-	p.mu.Unlock()
+		var pricing map[string]ModelPricing
+		if err := json.Unmarshal(data, &pricing); err != nil {
+			lastErr = fmt.Errorf("%s: failed to decode pricing data: %w", src.Name(), err)
+			continue
+		}
 
-	return nil
+		p.mu.Lock()
+		p.pricing = pricing
+		p.lastFetched = time.Now()
+		p.mu.Unlock()
+		return nil
+	}
+
+	if lastErr == nil {
+		lastErr = errors.New("no pricing sources configured")
+	}
+	return fmt.Errorf("failed to refresh pricing data: %w", lastErr)
 }
 
 // GetPricing returns pricing for a specific model
@@ -115,45 +162,109 @@ func (p *PricingService) GetPricing(modelID string) (ModelPricing, bool) {
 	return ModelPricing{}, false
 }
 
-// CalculateCost calculates the cost for a given token usage
-func (p *PricingService) CalculateCost(modelID string, inputTokens, outputTokens, cachedTokens int64) float64 {
-	pricing, ok := p.GetPricing(modelID)
+// ServiceTier selects which LiteLLM rate table a Usage is billed against.
+type ServiceTier string
+
+const (
+	TierStandard ServiceTier = "standard"
+	TierBatch    ServiceTier = "batch"
+	TierPriority ServiceTier = "priority"
+)
+
+// Usage describes the token counts for a single cost calculation. Cached
+// reads/writes and reasoning tokens are tracked separately from
+// InputTokens/OutputTokens rather than folded into them, so each can be
+// billed at its own rate.
+type Usage struct {
+	InputTokens       int64
+	OutputTokens      int64
+	CachedReadTokens  int64
+	CachedWriteTokens int64
+	ReasoningTokens   int64
+	ServiceTier       ServiceTier
+}
+
+// CostBreakdown itemizes the cost components of a Usage so callers can show
+// true input/output/cache/reasoning splits instead of calling
+// CalculateCost twice with zeroed fields.
+type CostBreakdown struct {
+	InputCost       float64
+	OutputCost      float64
+	CachedReadCost  float64
+	CachedWriteCost float64
+	ReasoningCost   float64
+}
+
+// Total returns the sum of every line item.
+func (c CostBreakdown) Total() float64 {
+	return c.InputCost + c.OutputCost + c.CachedReadCost + c.CachedWriteCost + c.ReasoningCost
+}
+
+// CalculateCost calculates the cost of usage for modelID, picking batch or
+// priority rates when usage.ServiceTier requests them and the model
+// publishes non-zero rates for that tier, falling back to standard rates
+// otherwise.
+func (p *PricingService) CalculateCost(modelID string, usage Usage) CostBreakdown {
+	mp, ok := p.GetPricing(modelID)
 	if !ok {
-		return 0
+		return CostBreakdown{}
 	}
 
-	var inputCost, outputCost float64
+	inputRate := mp.InputCostPerToken
+	outputRate := mp.OutputCostPerToken
 
-	// Calculate input cost with tiered pricing
-	inputTokensF := float64(inputTokens)
-	if inputTokens > 200000 && pricing.InputCostPerTokenAbove200k > 0 {
-		inputCost = inputTokensF * pricing.InputCostPerTokenAbove200k
-	} else if inputTokens > 128000 && pricing.InputCostPerTokenAbove128k > 0 {
-		inputCost = inputTokensF * pricing.InputCostPerTokenAbove128k
-	} else if pricing.InputCostPerToken > 0 {
-		inputCost = inputTokensF * pricing.InputCostPerToken
+	switch usage.ServiceTier {
+	case TierBatch:
+		if mp.InputCostPerTokenBatches > 0 {
+			inputRate = mp.InputCostPerTokenBatches
+		}
+		if mp.OutputCostPerTokenBatches > 0 {
+			outputRate = mp.OutputCostPerTokenBatches
+		}
+	case TierPriority:
+		if mp.InputCostPerTokenPriority > 0 {
+			inputRate = mp.InputCostPerTokenPriority
+		}
+		if mp.OutputCostPerTokenPriority > 0 {
+			outputRate = mp.OutputCostPerTokenPriority
+		}
 	}
 
-	// Apply cached token discount
-	if cachedTokens > 0 && pricing.CacheReadInputTokenCost > 0 {
-		// Subtract the regular cost for cached tokens and add the discounted cost
-		cachedTokensF := float64(cachedTokens)
-		regularCost := cachedTokensF * pricing.InputCostPerToken
-		cachedCost := cachedTokensF * pricing.CacheReadInputTokenCost
-		inputCost = inputCost - regularCost + cachedCost
+	var breakdown CostBreakdown
+
+	// Input cost, with tiered pricing above 128k/200k tokens.
+	inputTokensF := float64(usage.InputTokens)
+	switch {
+	case usage.InputTokens > 200000 && mp.InputCostPerTokenAbove200k > 0:
+		breakdown.InputCost = inputTokensF * mp.InputCostPerTokenAbove200k
+	case usage.InputTokens > 128000 && mp.InputCostPerTokenAbove128k > 0:
+		breakdown.InputCost = inputTokensF * mp.InputCostPerTokenAbove128k
+	default:
+		breakdown.InputCost = inputTokensF * inputRate
 	}
 
-	// Calculate output cost with tiered pricing
-	outputTokensF := float64(outputTokens)
-	if outputTokens > 200000 && pricing.OutputCostPerTokenAbove200k > 0 {
-		outputCost = outputTokensF * pricing.OutputCostPerTokenAbove200k
-	} else if outputTokens > 128000 && pricing.OutputCostPerTokenAbove128k > 0 {
-		outputCost = outputTokensF * pricing.OutputCostPerTokenAbove128k
-	} else if pricing.OutputCostPerToken > 0 {
-		outputCost = outputTokensF * pricing.OutputCostPerToken
+	// Output cost, with tiered pricing above 128k/200k tokens.
+	outputTokensF := float64(usage.OutputTokens)
+	switch {
+	case usage.OutputTokens > 200000 && mp.OutputCostPerTokenAbove200k > 0:
+		breakdown.OutputCost = outputTokensF * mp.OutputCostPerTokenAbove200k
+	case usage.OutputTokens > 128000 && mp.OutputCostPerTokenAbove128k > 0:
+		breakdown.OutputCost = outputTokensF * mp.OutputCostPerTokenAbove128k
+	default:
+		breakdown.OutputCost = outputTokensF * outputRate
+	}
+
+	// Reasoning tokens bill at the output rate.
+	breakdown.ReasoningCost = float64(usage.ReasoningTokens) * outputRate
+
+	if usage.CachedReadTokens > 0 && mp.CacheReadInputTokenCost > 0 {
+		breakdown.CachedReadCost = float64(usage.CachedReadTokens) * mp.CacheReadInputTokenCost
+	}
+	if usage.CachedWriteTokens > 0 && mp.CacheCreationInputTokenCost > 0 {
+		breakdown.CachedWriteCost = float64(usage.CachedWriteTokens) * mp.CacheCreationInputTokenCost
 	}
 
-	return inputCost + outputCost
+	return breakdown
 }
 
 // NeedsRefresh returns true if pricing data needs to be refreshed
@@ -0,0 +1,149 @@
+package pricing
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNotModified is returned by a PricingSource when the remote data has not
+// changed since the last successful fetch (e.g. an HTTP 304 response). The
+// service treats it as "keep what we already have" rather than as a failure.
+var ErrNotModified = errors.New("pricing: not modified")
+
+// PricingSource supplies raw LiteLLM-format pricing JSON. Implementations may
+// be remote (HTTP), local (a file on disk), or baked into the binary
+// (embedded), allowing PricingService to fall back across them in order.
+type PricingSource interface {
+	// Name identifies the source for logging/diagnostics.
+	Name() string
+	// Fetch returns the raw pricing JSON, or ErrNotModified if the source
+	// supports conditional requests and nothing has changed.
+	Fetch(ctx context.Context) ([]byte, error)
+}
+
+// HTTPSource fetches pricing data over HTTP, remembering the ETag and
+// Last-Modified response headers so subsequent fetches can be conditional.
+type HTTPSource struct {
+	URL    string
+	Client *http.Client
+
+	mu           sync.Mutex
+	etag         string
+	lastModified string
+}
+
+// NewHTTPSource creates an HTTPSource against url using a default client.
+func NewHTTPSource(url string) *HTTPSource {
+	return &HTTPSource{
+		URL:    url,
+		Client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Name implements PricingSource.
+func (s *HTTPSource) Name() string {
+	return "http:" + s.URL
+}
+
+// Fetch implements PricingSource, sending If-None-Match/If-Modified-Since
+// when a previous response supplied caching headers.
+func (s *HTTPSource) Fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build pricing request: %w", err)
+	}
+
+	s.mu.Lock()
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+	if s.lastModified != "" {
+		req.Header.Set("If-Modified-Since", s.lastModified)
+	}
+	s.mu.Unlock()
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pricing data: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, ErrNotModified
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pricing data: %w", err)
+	}
+
+	s.mu.Lock()
+	s.etag = resp.Header.Get("ETag")
+	s.lastModified = resp.Header.Get("Last-Modified")
+	s.mu.Unlock()
+
+	return body, nil
+}
+
+// FileSource reads pricing data from a local JSON file, e.g. a snapshot
+// shared across a team or mounted into an air-gapped environment.
+type FileSource struct {
+	Path string
+}
+
+// NewFileSource creates a FileSource reading from path.
+func NewFileSource(path string) *FileSource {
+	return &FileSource{Path: path}
+}
+
+// Name implements PricingSource.
+func (s *FileSource) Name() string {
+	return "file:" + s.Path
+}
+
+// Fetch implements PricingSource.
+func (s *FileSource) Fetch(ctx context.Context) ([]byte, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pricing file: %w", err)
+	}
+	return data, nil
+}
+
+//go:embed embedded/pricing_snapshot.json
+var embeddedPricingSnapshot []byte
+
+// EmbeddedSource serves a pricing snapshot baked into the binary at build
+// time, used as the last resort when no network or local file is available.
+type EmbeddedSource struct{}
+
+// NewEmbeddedSource creates an EmbeddedSource.
+func NewEmbeddedSource() EmbeddedSource {
+	return EmbeddedSource{}
+}
+
+// Name implements PricingSource.
+func (EmbeddedSource) Name() string {
+	return "embedded"
+}
+
+// Fetch implements PricingSource.
+func (EmbeddedSource) Fetch(ctx context.Context) ([]byte, error) {
+	return embeddedPricingSnapshot, nil
+}
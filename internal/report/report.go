@@ -0,0 +1,99 @@
+// Package report renders plain-text, top-like snapshots of opencode usage
+// for headless environments, piping, and scripting where the Bubble Tea
+// dashboard doesn't apply.
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/razobeckett/openmetrics/internal/db"
+	"github.com/razobeckett/openmetrics/internal/ingest"
+	"github.com/razobeckett/openmetrics/internal/pricing"
+)
+
+// Snapshot renders the highest-cost models and most-recently-active
+// sessions as a compact plain-text table.
+type Snapshot struct {
+	sources        []string
+	pricingService *pricing.PricingService
+	limit          int
+}
+
+// NewSnapshot creates a Snapshot reading and merging sources (--source specs
+// parsed by ingest.Parse), pricing usage via ps, and limiting each section
+// to the top limit rows.
+func NewSnapshot(sources []string, ps *pricing.PricingService, limit int) *Snapshot {
+	return &Snapshot{sources: sources, pricingService: ps, limit: limit}
+}
+
+// Render loads and merges s.sources and returns the formatted snapshot.
+func (s *Snapshot) Render() (string, error) {
+	all := make([]ingest.Stats, 0, len(s.sources))
+	for _, spec := range s.sources {
+		ingestor, err := ingest.Parse(spec)
+		if err != nil {
+			return "", fmt.Errorf("report: %w", err)
+		}
+
+		stats, err := ingestor.Load()
+		if err != nil {
+			return "", fmt.Errorf("report: failed to load %s: %w", ingestor.Name(), err)
+		}
+		all = append(all, stats)
+	}
+
+	merged := ingest.Merge(all)
+	models := merged.Models
+	sessions := merged.Sessions
+
+	for i := range models {
+		models[i].Cost = pricing.EffectiveCost(models[i], s.pricingService)
+	}
+
+	sort.Slice(models, func(i, j int) bool {
+		return models[i].Cost > models[j].Cost
+	})
+	if len(models) > s.limit {
+		models = models[:s.limit]
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].TimeUpdated > sessions[j].TimeUpdated
+	})
+	if len(sessions) > s.limit {
+		sessions = sessions[:s.limit]
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "openmetrics overview  %s\n\n", time.Now().Format("2006-01-02 15:04:05"))
+
+	b.WriteString("TOP MODELS BY COST\n")
+	fmt.Fprintf(&b, "%-30s %-14s %10s %10s %10s\n", "MODEL", "PROVIDER", "IN", "OUT", "COST")
+	for _, m := range models {
+		fmt.Fprintf(&b, "%-30s %-14s %10s %10s %10s\n",
+			truncate(m.Model, 30), truncate(m.Provider, 14),
+			pricing.FormatTokens(m.InputTokens), pricing.FormatTokens(m.OutputTokens), pricing.FormatCost(m.Cost))
+	}
+
+	b.WriteString("\nRECENT SESSIONS\n")
+	fmt.Fprintf(&b, "%-40s %8s %10s %12s\n", "TITLE", "MSGS", "TOKENS", "UPDATED")
+	for _, sess := range sessions {
+		fmt.Fprintf(&b, "%-40s %8d %10s %12s\n",
+			truncate(sess.Title, 40), sess.MessageCount, pricing.FormatTokens(sess.TotalTokens), db.FormatTimeAgo(sess.TimeUpdated))
+	}
+
+	return b.String(), nil
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	if max < 3 {
+		return s[:max]
+	}
+	return s[:max-3] + "..."
+}
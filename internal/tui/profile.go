@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"os"
+
+	"github.com/muesli/termenv"
+)
+
+// TerminalProfile is how much rendering capability a terminal has, from
+// full truecolor box-drawing down to plain ASCII text.
+type TerminalProfile int
+
+const (
+	ProfileTrueColor TerminalProfile = iota
+	ProfileANSI256
+	ProfileANSI16
+	// ProfileAscii is the bottom rung: no color, ASCII-only borders, and
+	// plain-text FormatTokenCount/FormatCost output. Used for legacy
+	// Windows conhost, TERM=dumb, NO_COLOR, and CI log capture.
+	ProfileAscii
+)
+
+// currentProfile is the profile last applied via SetProfile.
+var currentProfile = ProfileTrueColor
+
+// DetectProfile inspects the current theme's renderer color profile plus
+// TERM, NO_COLOR, and CI to pick the best TerminalProfile this terminal
+// can actually render. It does not apply anything; pass its result to
+// SetProfile.
+func DetectProfile() TerminalProfile {
+	if os.Getenv("NO_COLOR") != "" {
+		return ProfileAscii
+	}
+	if _, ci := os.LookupEnv("CI"); ci {
+		return ProfileAscii
+	}
+	if os.Getenv("TERM") == "dumb" {
+		return ProfileAscii
+	}
+
+	switch currentTheme.Renderer.ColorProfile() {
+	case termenv.TrueColor:
+		return ProfileTrueColor
+	case termenv.ANSI256:
+		return ProfileANSI256
+	case termenv.ANSI:
+		return ProfileANSI16
+	default:
+		return ProfileAscii
+	}
+}
+
+// SetProfile applies p, setting the current theme's renderer to the
+// matching color profile (no color for ProfileAscii), swapping every border
+// in this package between its unicode and ASCII variant and rebuilding
+// styles against that renderer. FormatTokenCount and FormatCost read
+// currentProfile directly to decide whether to wrap their output in a
+// style at all.
+func SetProfile(p TerminalProfile) {
+	currentProfile = p
+
+	switch p {
+	case ProfileTrueColor:
+		currentTheme.Renderer.SetColorProfile(termenv.TrueColor)
+	case ProfileANSI256:
+		currentTheme.Renderer.SetColorProfile(termenv.ANSI256)
+	case ProfileANSI16:
+		currentTheme.Renderer.SetColorProfile(termenv.ANSI)
+	default:
+		currentTheme.Renderer.SetColorProfile(termenv.Ascii)
+	}
+
+	if p == ProfileAscii {
+		activeTabBorder = asciiActiveTabBorder
+		tabBorder = asciiTabBorder
+		verticalTabBorder = asciiVerticalTabBorder
+		verticalActiveTabBorder = asciiVerticalActiveTabBorder
+		boxBorder = asciiBoxBorder
+	} else {
+		activeTabBorder = unicodeActiveTabBorder
+		tabBorder = unicodeTabBorder
+		verticalTabBorder = unicodeVerticalTabBorder
+		verticalActiveTabBorder = unicodeVerticalActiveTabBorder
+		boxBorder = unicodeBoxBorder
+	}
+
+	rebuildStyles(currentTheme.Renderer)
+}
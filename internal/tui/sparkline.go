@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var sparkBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Sparkline renders values as a compact unicode block trend line of width
+// columns, styled with style. Values are resampled to exactly width
+// columns so callers can pass any number of data points.
+func Sparkline(values []float64, width int, style lipgloss.Style) string {
+	if width <= 0 || len(values) == 0 {
+		return ""
+	}
+
+	sampled := resample(values, width)
+
+	min, max := sampled[0], sampled[0]
+	for _, v := range sampled {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range sampled {
+		b.WriteRune(blockFor(v, min, max))
+	}
+
+	return style.Render(b.String())
+}
+
+// HBar renders a single horizontal bar of width characters, filled
+// proportionally to value/max.
+func HBar(value, max float64, width int, style lipgloss.Style) string {
+	if width <= 0 {
+		return ""
+	}
+	if max <= 0 {
+		return style.Render(strings.Repeat(" ", width))
+	}
+
+	ratio := value / max
+	if ratio < 0 {
+		ratio = 0
+	}
+	if ratio > 1 {
+		ratio = 1
+	}
+
+	filled := int(ratio * float64(width))
+	return style.Render(strings.Repeat("█", filled) + strings.Repeat("░", width-filled))
+}
+
+// FormatTokenTrend renders a token-count trend (e.g. per-day totals) as a
+// sparkline, colored like FormatTokenCount.
+func FormatTokenTrend(counts []int64, width int) string {
+	values := make([]float64, len(counts))
+	var max int64
+	for i, c := range counts {
+		values[i] = float64(c)
+		if c > max {
+			max = c
+		}
+	}
+
+	style := tokenMutedStyle
+	if max >= 1_000 {
+		style = tokenInfoStyle
+	}
+	return Sparkline(values, width, style)
+}
+
+// FormatCostTrend renders a cost trend (e.g. per-day totals) as a
+// sparkline, colored from the same success/warning/error thresholds as
+// FormatCost.
+func FormatCostTrend(costs []float64, width int) string {
+	var max float64
+	for _, c := range costs {
+		if c > max {
+			max = c
+		}
+	}
+
+	style := costSuccessStyle
+	if max > 100 {
+		style = costWarningStyle
+	}
+	if max > 500 {
+		style = costErrorStyle
+	}
+
+	return Sparkline(costs, width, style)
+}
+
+func blockFor(v, min, max float64) rune {
+	if max == min {
+		return sparkBlocks[0]
+	}
+	idx := int((v - min) / (max - min) * float64(len(sparkBlocks)-1))
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sparkBlocks) {
+		idx = len(sparkBlocks) - 1
+	}
+	return sparkBlocks[idx]
+}
+
+// resample maps values onto exactly width columns, sampling the nearest
+// source value per column.
+func resample(values []float64, width int) []float64 {
+	if len(values) == width {
+		return values
+	}
+
+	out := make([]float64, width)
+	for i := range out {
+		srcIdx := i * len(values) / width
+		if srcIdx >= len(values) {
+			srcIdx = len(values) - 1
+		}
+		out[i] = values[srcIdx]
+	}
+	return out
+}
@@ -2,33 +2,38 @@ package tui
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
+// Color* vars hold the active theme's palette, kept as package-level
+// variables (rather than constants) so ApplyTheme can swap them at
+// runtime. They default to DefaultDarkTheme via this package's init.
 var (
-	ColorPrimary   = lipgloss.Color("#7D56F4")
-	ColorSecondary = lipgloss.Color("#04B575")
-	ColorAccent    = lipgloss.Color("#FF6B6B")
-	ColorHighlight = lipgloss.Color("#FFD93D")
-
-	ColorText      = lipgloss.Color("#FAFAFA")
-	ColorTextMuted = lipgloss.Color("#6C6C6C")
-	ColorTextDim   = lipgloss.Color("#3C3C3C")
-
-	ColorBackground = lipgloss.Color("#1A1A2E")
-	ColorSurface    = lipgloss.Color("#16213E")
-	ColorBorder     = lipgloss.Color("#3A3A5C")
-
-	ColorSuccess = lipgloss.Color("#04B575")
-	ColorWarning = lipgloss.Color("#FFD93D")
-	ColorError   = lipgloss.Color("#FF6B6B")
-	ColorInfo    = lipgloss.Color("#6CB4EE")
+	ColorPrimary   lipgloss.AdaptiveColor
+	ColorSecondary lipgloss.AdaptiveColor
+	ColorAccent    lipgloss.AdaptiveColor
+	ColorHighlight lipgloss.AdaptiveColor
+
+	ColorText      lipgloss.AdaptiveColor
+	ColorTextMuted lipgloss.AdaptiveColor
+	ColorTextDim   lipgloss.AdaptiveColor
+
+	ColorBackground lipgloss.AdaptiveColor
+	ColorSurface    lipgloss.AdaptiveColor
+	ColorBorder     lipgloss.AdaptiveColor
+
+	ColorSuccess lipgloss.AdaptiveColor
+	ColorWarning lipgloss.AdaptiveColor
+	ColorError   lipgloss.AdaptiveColor
+	ColorInfo    lipgloss.AdaptiveColor
 )
 
+// unicode*Border are this package's default box-drawing borders. The
+// unadorned vars below (activeTabBorder, tabBorder, ...) point at these
+// until SetProfile(ProfileAscii) swaps them for the ascii*Border set.
 var (
-	activeTabBorder = lipgloss.Border{
+	unicodeActiveTabBorder = lipgloss.Border{
 		Top:         "─",
 		Bottom:      " ",
 		Left:        "│",
@@ -39,7 +44,7 @@ var (
 		BottomRight: "└",
 	}
 
-	tabBorder = lipgloss.Border{
+	unicodeTabBorder = lipgloss.Border{
 		Top:         "─",
 		Bottom:      "─",
 		Left:        "│",
@@ -50,158 +55,326 @@ var (
 		BottomRight: "┴",
 	}
 
-	Title = lipgloss.NewStyle().
+	// unicodeVerticalTabBorder/unicodeVerticalActiveTabBorder are
+	// unicodeTabBorder/unicodeActiveTabBorder rotated 90° for
+	// RenderVerticalTabBar's sidebar layout.
+	unicodeVerticalTabBorder = lipgloss.Border{
+		Top:         "─",
+		Bottom:      "─",
+		Left:        "│",
+		Right:       "│",
+		TopLeft:     "╭",
+		TopRight:    "╮",
+		BottomLeft:  "╰",
+		BottomRight: "╯",
+	}
+
+	unicodeVerticalActiveTabBorder = lipgloss.Border{
+		Top:         "─",
+		Bottom:      "─",
+		Left:        "│",
+		Right:       " ",
+		TopLeft:     "╭",
+		TopRight:    "╮",
+		BottomLeft:  "╰",
+		BottomRight: "╯",
+	}
+
+	unicodeBoxBorder = lipgloss.RoundedBorder()
+
+	// ascii*Border are the unicode set's substitutes for terminals that
+	// can't render box-drawing characters (legacy Windows conhost,
+	// TERM=dumb, CI log output). SetProfile(ProfileAscii) swaps to these.
+	asciiActiveTabBorder = lipgloss.Border{
+		Top:         "-",
+		Bottom:      " ",
+		Left:        "|",
+		Right:       "|",
+		TopLeft:     "+",
+		TopRight:    "+",
+		BottomLeft:  "+",
+		BottomRight: "+",
+	}
+
+	asciiTabBorder = lipgloss.Border{
+		Top:         "-",
+		Bottom:      "-",
+		Left:        "|",
+		Right:       "|",
+		TopLeft:     "+",
+		TopRight:    "+",
+		BottomLeft:  "+",
+		BottomRight: "+",
+	}
+
+	asciiVerticalTabBorder = lipgloss.Border{
+		Top:         "-",
+		Bottom:      "-",
+		Left:        "|",
+		Right:       "|",
+		TopLeft:     "+",
+		TopRight:    "+",
+		BottomLeft:  "+",
+		BottomRight: "+",
+	}
+
+	asciiVerticalActiveTabBorder = lipgloss.Border{
+		Top:         "-",
+		Bottom:      "-",
+		Left:        "|",
+		Right:       " ",
+		TopLeft:     "+",
+		TopRight:    "+",
+		BottomLeft:  "+",
+		BottomRight: "+",
+	}
+
+	asciiBoxBorder = lipgloss.NormalBorder()
+)
+
+// activeTabBorder, tabBorder, verticalTabBorder, verticalActiveTabBorder,
+// and boxBorder are the borders rebuildStyles actually draws with.
+// SetProfile points them at the unicode or ascii set; they default to
+// unicode.
+var (
+	activeTabBorder         = unicodeActiveTabBorder
+	tabBorder               = unicodeTabBorder
+	verticalTabBorder       = unicodeVerticalTabBorder
+	verticalActiveTabBorder = unicodeVerticalActiveTabBorder
+	boxBorder               = unicodeBoxBorder
+)
+
+// Styles below are rebuilt by rebuildStyles whenever ApplyTheme runs, so
+// they always reflect the current theme's palette and renderer.
+var (
+	Title lipgloss.Style
+
+	Subtitle lipgloss.Style
+
+	TabInactive lipgloss.Style
+	TabActive   lipgloss.Style
+	TabGap      lipgloss.Style
+
+	ContentBox lipgloss.Style
+	Container  lipgloss.Style
+	Card       lipgloss.Style
+
+	TableHeader lipgloss.Style
+	TableRow    lipgloss.Style
+	TableRowAlt lipgloss.Style
+
+	TextBold   lipgloss.Style
+	TextItalic lipgloss.Style
+	TextMuted  lipgloss.Style
+	TextAccent lipgloss.Style
+
+	Badge        lipgloss.Style
+	BadgeSuccess lipgloss.Style
+
+	StatValue lipgloss.Style
+	StatLabel lipgloss.Style
+
+	SpinnerStyle lipgloss.Style
+
+	ComingSoon lipgloss.Style
+
+	// tokenInfoStyle/tokenMutedStyle back FormatTokenCount.
+	tokenInfoStyle  lipgloss.Style
+	tokenMutedStyle lipgloss.Style
+
+	// cost*Style back FormatCost's success/warning/error thresholds.
+	costSuccessStyle lipgloss.Style
+	costWarningStyle lipgloss.Style
+	costErrorStyle   lipgloss.Style
+
+	// vertical tab styles back RenderVerticalTabBar.
+	verticalTabInactive lipgloss.Style
+	verticalTabActive   lipgloss.Style
+)
+
+// rebuildStyles reconstructs every style in this package against r, using
+// the Color* package vars ApplyTheme has just set.
+func rebuildStyles(r *lipgloss.Renderer) {
+	Title = r.NewStyle().
 		Bold(true).
 		Foreground(ColorPrimary).
 		Padding(0, 1)
 
-	Subtitle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorText).
-			Padding(0, 1)
+	Subtitle = r.NewStyle().
+		Bold(true).
+		Foreground(ColorText).
+		Padding(0, 1)
 
-	TabInactive = lipgloss.NewStyle().
-			Border(tabBorder).
-			BorderForeground(ColorPrimary).
-			Foreground(ColorTextMuted).
-			Padding(0, 2)
+	TabInactive = r.NewStyle().
+		Border(tabBorder).
+		BorderForeground(ColorPrimary).
+		Foreground(ColorTextMuted).
+		Padding(0, 2)
 
 	TabActive = TabInactive.
-			Border(activeTabBorder, true).
-			BorderForeground(ColorPrimary).
-			Foreground(ColorText).
-			Bold(true)
+		Border(activeTabBorder, true).
+		BorderForeground(ColorPrimary).
+		Foreground(ColorText).
+		Bold(true)
 
-	TabGap = lipgloss.NewStyle().
+	TabGap = r.NewStyle().
 		BorderTop(false).
 		BorderLeft(false).
 		BorderRight(false).
 		BorderBottom(true).
 		BorderForeground(ColorPrimary)
 
-	ContentBox = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorPrimary).
-			Padding(1, 2)
+	ContentBox = r.NewStyle().
+		Border(boxBorder).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2)
 
-	Container = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorBorder).
-			Padding(1, 2)
+	Container = r.NewStyle().
+		Border(boxBorder).
+		BorderForeground(ColorBorder).
+		Padding(1, 2)
 
-	Card = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+	Card = r.NewStyle().
+		Border(boxBorder).
 		BorderForeground(ColorBorder).
 		Background(ColorSurface).
 		Padding(1, 1)
 
-	TableHeader = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorPrimary).
-			Padding(0, 1)
+	TableHeader = r.NewStyle().
+		Bold(true).
+		Foreground(ColorPrimary).
+		Padding(0, 1)
 
-	TableRow = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Padding(0, 1)
+	TableRow = r.NewStyle().
+		Foreground(ColorText).
+		Padding(0, 1)
 
-	TableRowAlt = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Background(lipgloss.Color("#1E1E3F")).
-			Padding(0, 1)
+	TableRowAlt = r.NewStyle().
+		Foreground(ColorText).
+		Background(ColorTableRowAltBackground()).
+		Padding(0, 1)
 
-	TextBold   = lipgloss.NewStyle().Bold(true)
-	TextItalic = lipgloss.NewStyle().Italic(true)
-	TextMuted  = lipgloss.NewStyle().Foreground(ColorTextMuted)
-	TextAccent = lipgloss.NewStyle().Foreground(ColorPrimary)
+	TextBold = r.NewStyle().Bold(true)
+	TextItalic = r.NewStyle().Italic(true)
+	TextMuted = r.NewStyle().Foreground(ColorTextMuted)
+	TextAccent = r.NewStyle().Foreground(ColorPrimary)
 
-	Badge = lipgloss.NewStyle().
+	Badge = r.NewStyle().
 		Foreground(ColorText).
 		Background(ColorPrimary).
 		Padding(0, 1)
 
-	BadgeSuccess = lipgloss.NewStyle().
-			Foreground(ColorText).
-			Background(ColorSuccess).
-			Padding(0, 1)
-
-	StatValue = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorPrimary).
-			Padding(0, 1)
+	BadgeSuccess = r.NewStyle().
+		Foreground(ColorText).
+		Background(ColorSuccess).
+		Padding(0, 1)
 
-	StatLabel = lipgloss.NewStyle().
-			Foreground(ColorTextMuted).
-			Padding(0, 1)
+	StatValue = r.NewStyle().
+		Bold(true).
+		Foreground(ColorPrimary).
+		Padding(0, 1)
 
-	SpinnerStyle = lipgloss.NewStyle().
-			Foreground(ColorPrimary)
+	StatLabel = r.NewStyle().
+		Foreground(ColorTextMuted).
+		Padding(0, 1)
 
-	ComingSoon = lipgloss.NewStyle().
-			Foreground(ColorTextMuted).
-			Italic(true).
-			Align(lipgloss.Center)
-)
+	SpinnerStyle = r.NewStyle().
+		Foreground(ColorPrimary)
 
-func GetTabStyle(text string, active bool) string {
-	if active {
-		return TabActive.Render(text)
-	}
-	return TabInactive.Render(text)
-}
+	ComingSoon = r.NewStyle().
+		Foreground(ColorTextMuted).
+		Italic(true).
+		Align(lipgloss.Center)
 
-func RenderTabBar(tabs []string, activeIndex int, width int) string {
-	renderedTabs := make([]string, len(tabs))
-	for i, tab := range tabs {
-		renderedTabs[i] = GetTabStyle(tab, i == activeIndex)
-	}
+	tokenInfoStyle = r.NewStyle().Foreground(ColorInfo)
+	tokenMutedStyle = r.NewStyle().Foreground(ColorTextMuted)
 
-	row := lipgloss.JoinHorizontal(lipgloss.Top, renderedTabs...)
+	costSuccessStyle = r.NewStyle().Foreground(ColorSuccess)
+	costWarningStyle = r.NewStyle().Foreground(ColorWarning)
+	costErrorStyle = r.NewStyle().Foreground(ColorError)
 
-	remainingWidth := width - lipgloss.Width(row)
-	if remainingWidth < 0 {
-		remainingWidth = 0
-	}
+	verticalTabInactive = r.NewStyle().
+		Border(verticalTabBorder).
+		BorderForeground(ColorBorder).
+		Foreground(ColorTextMuted).
+		Padding(0, 2)
 
-	gap := TabGap.
+	verticalTabActive = verticalTabInactive.
+		Border(verticalActiveTabBorder, true).
 		BorderForeground(ColorPrimary).
-		Foreground(ColorPrimary).
-		Render(strings.Repeat("─", remainingWidth))
+		Foreground(ColorText).
+		Bold(true)
+}
+
+// ColorTableRowAltBackground returns the current theme's alternate table
+// row background, falling back to the default dark theme's value if no
+// theme has been applied yet (should not happen outside of tests).
+func ColorTableRowAltBackground() lipgloss.AdaptiveColor {
+	if currentTheme.Palette.TableRowAltBackground == (lipgloss.AdaptiveColor{}) {
+		return DefaultDarkTheme().Palette.TableRowAltBackground
+	}
+	return currentTheme.Palette.TableRowAltBackground
+}
 
-	return lipgloss.JoinHorizontal(lipgloss.Bottom, row, gap)
+func GetTabStyle(text string, active bool) string {
+	if active {
+		return TabActive.Render(text)
+	}
+	return TabInactive.Render(text)
 }
 
+// FormatTokenCount renders count with a K/M suffix, colored by magnitude.
+// Under ProfileAscii it returns plain text so piping TUI output to a file
+// or grep produces clean output instead of escape codes.
 func FormatTokenCount(count int64) string {
 	if count >= 1_000_000 {
-		return lipgloss.NewStyle().Foreground(ColorInfo).Render(
-			formatNumber(float64(count)/1_000_000) + "M",
-		)
+		text := formatNumber(float64(count)/1_000_000) + "M"
+		if currentProfile == ProfileAscii {
+			return text
+		}
+		return tokenInfoStyle.Render(text)
 	}
 	if count >= 1_000 {
-		return lipgloss.NewStyle().Foreground(ColorInfo).Render(
-			formatNumber(float64(count)/1_000) + "K",
-		)
+		text := formatNumber(float64(count)/1_000) + "K"
+		if currentProfile == ProfileAscii {
+			return text
+		}
+		return tokenInfoStyle.Render(text)
+	}
+
+	text := formatNumber(float64(count))
+	if currentProfile == ProfileAscii {
+		return text
 	}
-	return lipgloss.NewStyle().Foreground(ColorTextMuted).Render(
-		formatNumber(float64(count)),
-	)
+	return tokenMutedStyle.Render(text)
 }
 
+// FormatCost renders cost as a dollar amount, colored by success/warning/
+// error thresholds. Under ProfileAscii it returns plain text, see
+// FormatTokenCount.
 func FormatCost(cost float64) string {
-	style := lipgloss.NewStyle().Foreground(ColorSuccess)
-	if cost > 100 {
-		style = lipgloss.NewStyle().Foreground(ColorWarning)
+	var text string
+	switch {
+	case cost == 0:
+		text = "$0.00"
+	case cost < 0.01:
+		text = fmt.Sprintf("$%.4f", cost)
+	default:
+		text = fmt.Sprintf("$%.2f", cost)
 	}
-	if cost > 500 {
-		style = lipgloss.NewStyle().Foreground(ColorError)
+
+	if currentProfile == ProfileAscii {
+		return text
 	}
 
-	if cost == 0 {
-		return style.Render("$0.00")
+	style := costSuccessStyle
+	if cost > 100 {
+		style = costWarningStyle
 	}
-	if cost < 0.01 {
-		return style.Render(fmt.Sprintf("$%.4f", cost))
+	if cost > 500 {
+		style = costErrorStyle
 	}
-	return style.Render(fmt.Sprintf("$%.2f", cost))
+	return style.Render(text)
 }
 
 func formatNumber(n float64) string {
@@ -0,0 +1,211 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// chevronReserve is the width budget reserved for the "‹ " / " ›" overflow
+// indicators and the "N/M" counter when a tab bar must window its tabs.
+const chevronReserve = 10
+
+// TabBarState tracks the scroll offset of a windowed tab bar so a Bubble
+// Tea model can drive it from key events (left/right, or jumping straight
+// to a tab) without recomputing the window from scratch each time.
+type TabBarState struct {
+	tabs        []string
+	activeIndex int
+	offset      int
+
+	// lastWidth is the width passed to the most recent Render call, used by
+	// ScrollLeft/ScrollRight to clamp offset to the widest useful value
+	// instead of letting it run past what Render's own clamp would ever
+	// show, which would otherwise take extra key presses to undo.
+	lastWidth int
+}
+
+// NewTabBarState creates a TabBarState over tabs with the first tab active
+// and the window focused on it.
+func NewTabBarState(tabs []string) *TabBarState {
+	return &TabBarState{tabs: tabs}
+}
+
+// SetActive moves the active tab to i, clamped to the tab slice, and slides
+// the window so it stays visible.
+func (s *TabBarState) SetActive(i int) {
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(s.tabs) {
+		i = len(s.tabs) - 1
+	}
+	s.activeIndex = i
+	s.FocusActive()
+}
+
+// ActiveIndex returns the currently active tab index.
+func (s *TabBarState) ActiveIndex() int {
+	return s.activeIndex
+}
+
+// ScrollLeft slides the window one tab to the left, without changing which
+// tab is active.
+func (s *TabBarState) ScrollLeft() {
+	if s.offset > 0 {
+		s.offset--
+	}
+}
+
+// ScrollRight slides the window one tab to the right, without changing
+// which tab is active, stopping once the window already shows the last
+// tab (per lastWidth) rather than letting offset run past that point.
+func (s *TabBarState) ScrollRight() {
+	if s.offset < s.maxOffset() {
+		s.offset++
+	}
+}
+
+// maxOffset returns the largest offset Render would actually act on: past
+// this point its own clamp (len(s.tabs)-count) keeps the window pinned to
+// the end, so scrolling further is a no-op. Falls back to len(s.tabs)-1
+// before Render has run once and established lastWidth.
+func (s *TabBarState) maxOffset() int {
+	if s.lastWidth <= 0 {
+		return len(s.tabs) - 1
+	}
+	max := len(s.tabs) - windowCount(s.tabs, s.lastWidth)
+	if max < 0 {
+		max = 0
+	}
+	return max
+}
+
+// FocusActive resets the scroll offset so the active tab is the leading
+// edge of the window. Render then pulls the offset back only as far as
+// needed to keep the window full.
+func (s *TabBarState) FocusActive() {
+	s.offset = s.activeIndex
+}
+
+// Render lays out s's tabs at width, starting the window at s.offset and
+// pulling it back just enough to keep the window full when there's room
+// to show tabs past the end.
+func (s *TabBarState) Render(width int) string {
+	s.lastWidth = width
+	if len(s.tabs) == 0 {
+		return ""
+	}
+
+	count := windowCount(s.tabs, width)
+	start := s.offset
+	if start > len(s.tabs)-count {
+		start = len(s.tabs) - count
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + count
+	if end > len(s.tabs) {
+		end = len(s.tabs)
+	}
+
+	return renderWindowed(s.tabs, s.activeIndex, start, end, width)
+}
+
+// RenderVerticalTabBar stacks tabs into a sidebar column, for terminals too
+// narrow for a horizontal bar. The active tab uses activeTabBorder's
+// sibling verticalActiveTabBorder, with its right edge left open so it
+// reads as attached to the content pane beside it.
+func RenderVerticalTabBar(tabs []string, activeIndex int) string {
+	rendered := make([]string, len(tabs))
+	for i, tab := range tabs {
+		style := verticalTabInactive
+		if i == activeIndex {
+			style = verticalTabActive
+		}
+		rendered[i] = style.Render(tab)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rendered...)
+}
+
+// windowCount returns how many of tabs fit within width. It first checks
+// whether the whole tab set fits with no chevrons at all; only once that
+// fails does it set chevronReserve aside for the overflow indicators, so a
+// tab bar that fits exactly isn't falsely judged as overflowing. Always
+// renders at least one tab even if it alone would overflow.
+func windowCount(tabs []string, width int) int {
+	if len(tabs) == 0 {
+		return 0
+	}
+
+	total := 0
+	for _, t := range tabs {
+		total += lipgloss.Width(GetTabStyle(t, false))
+	}
+	if total <= width {
+		return len(tabs)
+	}
+
+	avail := width - chevronReserve
+	if avail <= 0 {
+		return 1
+	}
+
+	sum, count := 0, 0
+	for _, t := range tabs {
+		w := lipgloss.Width(GetTabStyle(t, false))
+		if count > 0 && sum+w > avail {
+			break
+		}
+		sum += w
+		count++
+	}
+	if count == 0 {
+		count = 1
+	}
+	return count
+}
+
+// renderWindowed assembles the chevrons, the [start:end) slice of tabs, and
+// a trailing "N/M" counter into one row padded to width with TabGap.
+func renderWindowed(tabs []string, activeIndex, start, end, width int) string {
+	windowed := make([]string, end-start)
+	for i := start; i < end; i++ {
+		windowed[i-start] = GetTabStyle(tabs[i], i == activeIndex)
+	}
+	row := lipgloss.JoinHorizontal(lipgloss.Top, windowed...)
+
+	overflowing := start > 0 || end < len(tabs)
+	if overflowing {
+		left := "  "
+		if start > 0 {
+			left = TextAccent.Render("‹ ")
+		} else {
+			left = TextMuted.Render("  ")
+		}
+
+		right := "  "
+		if end < len(tabs) {
+			right = TextAccent.Render(" ›")
+		} else {
+			right = TextMuted.Render("  ")
+		}
+
+		counter := TextMuted.Render(fmt.Sprintf(" %d/%d", activeIndex+1, len(tabs)))
+		row = left + row + right + counter
+	}
+
+	remaining := width - lipgloss.Width(row)
+	if remaining < 0 {
+		remaining = 0
+	}
+	gap := TabGap.
+		BorderForeground(ColorPrimary).
+		Foreground(ColorPrimary).
+		Render(strings.Repeat("─", remaining))
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, row, gap)
+}
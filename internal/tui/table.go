@@ -0,0 +1,148 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// MetricsRow is one row of a MetricsTable: a model's token usage and cost.
+// InputCost/OutputCost are the input/output (plus cache and reasoning)
+// components of Cost, shown as their own columns so callers can see the
+// true split instead of just the combined total.
+type MetricsRow struct {
+	Model        string
+	Provider     string
+	InputTokens  int64
+	OutputTokens int64
+	InputCost    float64
+	OutputCost   float64
+	Cost         float64
+}
+
+// MetricsTable renders token usage and cost rows on top of
+// charmbracelet/lipgloss/table, so callers get automatic column width
+// fitting, alternating row backgrounds, right-aligned numeric columns, and
+// an optional totals footer instead of laying out columns by hand.
+type MetricsTable struct {
+	rows     []MetricsRow
+	selected int
+	width    int
+	height   int
+	totals   bool
+}
+
+// NewMetricsTable creates a MetricsTable over rows with no row selected.
+func NewMetricsTable(rows []MetricsRow) *MetricsTable {
+	return &MetricsTable{rows: rows, selected: -1}
+}
+
+// Width sets the rendered table's total width, e.g. from a Bubble Tea
+// model's Update on tea.WindowSizeMsg.
+func (t *MetricsTable) Width(w int) *MetricsTable {
+	t.width = w
+	return t
+}
+
+// Height sets the rendered table's total height.
+func (t *MetricsTable) Height(h int) *MetricsTable {
+	t.height = h
+	return t
+}
+
+// Select highlights row i for interactive selection. Pass -1 to clear it.
+func (t *MetricsTable) Select(i int) *MetricsTable {
+	t.selected = i
+	return t
+}
+
+// WithTotals toggles a footer row summing tokens and cost across all rows,
+// rendered with StatValue.
+func (t *MetricsTable) WithTotals(show bool) *MetricsTable {
+	t.totals = show
+	return t
+}
+
+// shareBarWidth is the column width, in characters, of the HBar rendered in
+// each row's "Share" cell.
+const shareBarWidth = 10
+
+// Render lays out the table, formatting each cell's text with
+// FormatTokenCount/FormatCost and applying, via StyleFunc, per-column
+// alignment and TableRowAlt on alternating rows.
+func (t *MetricsTable) Render() string {
+	tbl := table.New().
+		Headers("Model", "Provider", "Input", "Output", "In ($)", "Out ($)", "Cost", "Share").
+		Rows(t.rowStrings()...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			align := lipgloss.Left
+			if col >= 2 {
+				align = lipgloss.Right
+			}
+
+			switch {
+			case row == table.HeaderRow:
+				return TableHeader.Align(align)
+			case row == t.selected:
+				return TableRow.Background(ColorSurface).Bold(true).Align(align)
+			case row%2 == 1:
+				return TableRowAlt.Align(align)
+			default:
+				return TableRow.Align(align)
+			}
+		})
+
+	if t.width > 0 {
+		tbl = tbl.Width(t.width)
+	}
+	if t.height > 0 {
+		tbl = tbl.Height(t.height)
+	}
+
+	out := tbl.Render()
+	if t.totals {
+		out += "\n" + t.renderTotals()
+	}
+
+	return out
+}
+
+func (t *MetricsTable) rowStrings() [][]string {
+	var maxCost float64
+	for _, r := range t.rows {
+		if r.Cost > maxCost {
+			maxCost = r.Cost
+		}
+	}
+
+	rows := make([][]string, len(t.rows))
+	for i, r := range t.rows {
+		rows[i] = []string{
+			r.Model,
+			r.Provider,
+			FormatTokenCount(r.InputTokens),
+			FormatTokenCount(r.OutputTokens),
+			FormatCost(r.InputCost),
+			FormatCost(r.OutputCost),
+			FormatCost(r.Cost),
+			HBar(r.Cost, maxCost, shareBarWidth, TextMuted),
+		}
+	}
+	return rows
+}
+
+func (t *MetricsTable) renderTotals() string {
+	var inputTotal, outputTotal int64
+	var costTotal float64
+	for _, r := range t.rows {
+		inputTotal += r.InputTokens
+		outputTotal += r.OutputTokens
+		costTotal += r.Cost
+	}
+
+	return StatValue.Render(fmt.Sprintf(
+		"Total  In %s  Out %s  Cost %s",
+		FormatTokenCount(inputTotal), FormatTokenCount(outputTotal), FormatCost(costTotal),
+	))
+}
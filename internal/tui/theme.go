@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// Palette is the full set of semantic colors used throughout this package.
+// Every color carries both a light- and dark-background value so the TUI
+// reads correctly regardless of the user's terminal background.
+type Palette struct {
+	Primary   lipgloss.AdaptiveColor
+	Secondary lipgloss.AdaptiveColor
+	Accent    lipgloss.AdaptiveColor
+	Highlight lipgloss.AdaptiveColor
+
+	Text      lipgloss.AdaptiveColor
+	TextMuted lipgloss.AdaptiveColor
+	TextDim   lipgloss.AdaptiveColor
+
+	Background lipgloss.AdaptiveColor
+	Surface    lipgloss.AdaptiveColor
+	Border     lipgloss.AdaptiveColor
+
+	Success lipgloss.AdaptiveColor
+	Warning lipgloss.AdaptiveColor
+	Error   lipgloss.AdaptiveColor
+	Info    lipgloss.AdaptiveColor
+
+	StatValueBackground   lipgloss.AdaptiveColor
+	TableRowAltBackground lipgloss.AdaptiveColor
+}
+
+// Theme bundles a Palette with the renderer styles should be built against.
+// Carrying the renderer explicitly (rather than relying on lipgloss's
+// global singleton) lets consumers render to alternate outputs: SSH
+// sessions, log files, or tests forcing a particular color profile.
+type Theme struct {
+	Name     string
+	Palette  Palette
+	Renderer *lipgloss.Renderer
+}
+
+// DefaultDarkTheme is this package's original color scheme, extended with
+// light-background equivalents for each color.
+func DefaultDarkTheme() Theme {
+	return Theme{
+		Name: "default-dark",
+		Palette: Palette{
+			Primary:   lipgloss.AdaptiveColor{Light: "#6A3FD1", Dark: "#7D56F4"},
+			Secondary: lipgloss.AdaptiveColor{Light: "#03945E", Dark: "#04B575"},
+			Accent:    lipgloss.AdaptiveColor{Light: "#D94F4F", Dark: "#FF6B6B"},
+			Highlight: lipgloss.AdaptiveColor{Light: "#B38F00", Dark: "#FFD93D"},
+
+			Text:      lipgloss.AdaptiveColor{Light: "#1A1A1A", Dark: "#FAFAFA"},
+			TextMuted: lipgloss.AdaptiveColor{Light: "#6C6C6C", Dark: "#9B9B9B"},
+			TextDim:   lipgloss.AdaptiveColor{Light: "#B0B0B0", Dark: "#3C3C3C"},
+
+			Background: lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#1A1A2E"},
+			Surface:    lipgloss.AdaptiveColor{Light: "#F0F0F5", Dark: "#16213E"},
+			Border:     lipgloss.AdaptiveColor{Light: "#C8C8D8", Dark: "#3A3A5C"},
+
+			Success: lipgloss.AdaptiveColor{Light: "#03945E", Dark: "#04B575"},
+			Warning: lipgloss.AdaptiveColor{Light: "#B38F00", Dark: "#FFD93D"},
+			Error:   lipgloss.AdaptiveColor{Light: "#D94F4F", Dark: "#FF6B6B"},
+			Info:    lipgloss.AdaptiveColor{Light: "#2E6DA4", Dark: "#6CB4EE"},
+
+			StatValueBackground:   lipgloss.AdaptiveColor{Light: "#E8E8F0", Dark: "#1E1E3F"},
+			TableRowAltBackground: lipgloss.AdaptiveColor{Light: "#F5F5FA", Dark: "#1E1E3F"},
+		},
+	}
+}
+
+// SolarizedLightTheme is a built-in theme tuned for light terminal
+// backgrounds, using the Solarized Light palette.
+func SolarizedLightTheme() Theme {
+	return Theme{
+		Name: "solarized-light",
+		Palette: Palette{
+			Primary:   lipgloss.AdaptiveColor{Light: "#268BD2", Dark: "#268BD2"},
+			Secondary: lipgloss.AdaptiveColor{Light: "#2AA198", Dark: "#2AA198"},
+			Accent:    lipgloss.AdaptiveColor{Light: "#CB4B16", Dark: "#CB4B16"},
+			Highlight: lipgloss.AdaptiveColor{Light: "#B58900", Dark: "#B58900"},
+
+			Text:      lipgloss.AdaptiveColor{Light: "#073642", Dark: "#073642"},
+			TextMuted: lipgloss.AdaptiveColor{Light: "#657B83", Dark: "#657B83"},
+			TextDim:   lipgloss.AdaptiveColor{Light: "#93A1A1", Dark: "#93A1A1"},
+
+			Background: lipgloss.AdaptiveColor{Light: "#FDF6E3", Dark: "#FDF6E3"},
+			Surface:    lipgloss.AdaptiveColor{Light: "#EEE8D5", Dark: "#EEE8D5"},
+			Border:     lipgloss.AdaptiveColor{Light: "#93A1A1", Dark: "#93A1A1"},
+
+			Success: lipgloss.AdaptiveColor{Light: "#859900", Dark: "#859900"},
+			Warning: lipgloss.AdaptiveColor{Light: "#B58900", Dark: "#B58900"},
+			Error:   lipgloss.AdaptiveColor{Light: "#DC322F", Dark: "#DC322F"},
+			Info:    lipgloss.AdaptiveColor{Light: "#268BD2", Dark: "#268BD2"},
+
+			StatValueBackground:   lipgloss.AdaptiveColor{Light: "#EEE8D5", Dark: "#EEE8D5"},
+			TableRowAltBackground: lipgloss.AdaptiveColor{Light: "#EEE8D5", Dark: "#EEE8D5"},
+		},
+	}
+}
+
+// themeFile is the on-disk shape of a loadable theme: a name plus a
+// palette whose AdaptiveColor fields unmarshal directly from
+// {"Light": "#...", "Dark": "#..."} objects in JSON, where field names
+// match case-insensitively. In YAML the keys must be the lowercased field
+// names, e.g. textmuted: {light: "#...", dark: "#..."}.
+type themeFile struct {
+	Name    string  `json:"name" yaml:"name"`
+	Palette Palette `json:"palette" yaml:"palette"`
+}
+
+// LoadTheme reads a Theme from a JSON or YAML file (selected by extension:
+// .yaml/.yml vs anything else). The returned Theme has no Renderer set;
+// pass one via ApplyTheme or let it default to NewRenderer().
+func LoadTheme(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, fmt.Errorf("tui: failed to read theme file: %w", err)
+	}
+
+	var tf themeFile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &tf); err != nil {
+			return Theme{}, fmt.Errorf("tui: failed to parse YAML theme: %w", err)
+		}
+	default:
+		if err := json.Unmarshal(data, &tf); err != nil {
+			return Theme{}, fmt.Errorf("tui: failed to parse JSON theme: %w", err)
+		}
+	}
+
+	return Theme{Name: tf.Name, Palette: tf.Palette}, nil
+}
+
+// NewRenderer constructs a lipgloss.Renderer bound to os.Stdout, auto
+// detecting its color profile and background.
+func NewRenderer() *lipgloss.Renderer {
+	return lipgloss.NewRenderer(os.Stdout)
+}
+
+// currentTheme is the theme last applied via ApplyTheme.
+var currentTheme Theme
+
+// CurrentTheme returns the theme last applied via ApplyTheme.
+func CurrentTheme() Theme {
+	return currentTheme
+}
+
+// ApplyTheme rebuilds every package-level color and style against t's
+// palette and renderer. Call it once at startup, and again if the user
+// switches themes at runtime. If t.Renderer is nil, a renderer bound to
+// os.Stdout is created for it.
+func ApplyTheme(t Theme) {
+	if t.Renderer == nil {
+		t.Renderer = NewRenderer()
+	}
+	currentTheme = t
+
+	ColorPrimary = t.Palette.Primary
+	ColorSecondary = t.Palette.Secondary
+	ColorAccent = t.Palette.Accent
+	ColorHighlight = t.Palette.Highlight
+
+	ColorText = t.Palette.Text
+	ColorTextMuted = t.Palette.TextMuted
+	ColorTextDim = t.Palette.TextDim
+
+	ColorBackground = t.Palette.Background
+	ColorSurface = t.Palette.Surface
+	ColorBorder = t.Palette.Border
+
+	ColorSuccess = t.Palette.Success
+	ColorWarning = t.Palette.Warning
+	ColorError = t.Palette.Error
+	ColorInfo = t.Palette.Info
+
+	rebuildStyles(t.Renderer)
+}
+
+func init() {
+	ApplyTheme(DefaultDarkTheme())
+}
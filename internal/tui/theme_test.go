@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"io"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// forceRenderer builds a renderer bound to io.Discard with its background
+// pinned, so ApplyTheme's styles render deterministically regardless of the
+// terminal actually running the test.
+func forceRenderer(dark bool) *lipgloss.Renderer {
+	r := lipgloss.NewRenderer(io.Discard)
+	r.SetColorProfile(termenv.ANSI256)
+	r.SetHasDarkBackground(dark)
+	return r
+}
+
+func TestApplyThemeRendersEveryStyle(t *testing.T) {
+	defer ApplyTheme(DefaultDarkTheme())
+
+	themes := []struct {
+		name  string
+		theme Theme
+		dark  bool
+	}{
+		{"default-dark forced dark", DefaultDarkTheme(), true},
+		{"solarized-light forced light", SolarizedLightTheme(), false},
+	}
+
+	for _, tc := range themes {
+		t.Run(tc.name, func(t *testing.T) {
+			th := tc.theme
+			th.Renderer = forceRenderer(tc.dark)
+			ApplyTheme(th)
+
+			styles := map[string]lipgloss.Style{
+				"Title":        Title,
+				"Subtitle":     Subtitle,
+				"TabInactive":  TabInactive,
+				"TabActive":    TabActive,
+				"TabGap":       TabGap,
+				"ContentBox":   ContentBox,
+				"Container":    Container,
+				"Card":         Card,
+				"TableHeader":  TableHeader,
+				"TableRow":     TableRow,
+				"TableRowAlt":  TableRowAlt,
+				"TextBold":     TextBold,
+				"TextItalic":   TextItalic,
+				"TextMuted":    TextMuted,
+				"TextAccent":   TextAccent,
+				"Badge":        Badge,
+				"BadgeSuccess": BadgeSuccess,
+				"StatValue":    StatValue,
+				"StatLabel":    StatLabel,
+				"SpinnerStyle": SpinnerStyle,
+				"ComingSoon":   ComingSoon,
+			}
+
+			for name, style := range styles {
+				if out := style.Render("x"); out == "" {
+					t.Errorf("%s.Render(\"x\") returned empty output", name)
+				}
+			}
+
+			if got := GetTabStyle("Tab", true); got == "" {
+				t.Error("GetTabStyle(active) returned empty output")
+			}
+			if got := GetTabStyle("Tab", false); got == "" {
+				t.Error("GetTabStyle(inactive) returned empty output")
+			}
+		})
+	}
+}